@@ -0,0 +1,94 @@
+package torrentapi
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a pluggable cache for TorrentResults keyed by a query
+// fingerprint, wired in via WithCache. A Redis- or BoltDB-backed
+// implementation can be plugged in by satisfying this interface; LRUCache
+// is the in-memory default shipped with this package.
+type Cache interface {
+	// Get returns the cached results for key and true if present and not
+	// expired.
+	Get(key string) (TorrentResults, bool)
+	// Set stores results under key for the given ttl.
+	Set(key string, results TorrentResults, ttl time.Duration)
+}
+
+// DefaultCacheTTL is the TTL applied to cache entries when CacheTTL isn't
+// set.
+const DefaultCacheTTL = time.Minute * 5
+
+// DefaultLRUCacheCapacity is the entry count NewLRUCache falls back to when
+// called with capacity <= 0.
+const DefaultLRUCacheCapacity = 128
+
+type lruEntry struct {
+	key     string
+	results TorrentResults
+	expires time.Time
+}
+
+// LRUCache is an in-memory, size-bounded Cache.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+// NewLRUCache builds an LRUCache holding at most capacity entries. A
+// capacity <= 0 falls back to DefaultLRUCacheCapacity.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = DefaultLRUCacheCapacity
+	}
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(key string) (TorrentResults, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*lruEntry)
+	if time.Now().After(e.expires) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return e.results, true
+}
+
+// Set implements Cache.
+func (c *LRUCache) Set(key string, results TorrentResults, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.results, e.expires = results, time.Now().Add(ttl)
+		c.ll.MoveToFront(el)
+		return
+	}
+	el := c.ll.PushFront(&lruEntry{key: key, results: results, expires: time.Now().Add(ttl)})
+	c.items[key] = el
+	if c.capacity > 0 && c.ll.Len() > c.capacity {
+		if oldest := c.ll.Back(); oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
+
+var _ Cache = (*LRUCache)(nil)
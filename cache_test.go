@@ -0,0 +1,118 @@
+package torrentapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheGetSet(t *testing.T) {
+	c := NewLRUCache(2)
+	if _, ok := c.Get("missing"); ok {
+		t.Error("Get() on empty cache should miss")
+	}
+	want := TorrentResults{{Title: "Movie"}}
+	c.Set("a", want, time.Minute)
+	got, ok := c.Get("a")
+	if !ok {
+		t.Fatal("Get() after Set() should hit")
+	}
+	if len(got) != 1 || got[0].Title != "Movie" {
+		t.Errorf("Get() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLRUCacheExpires(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", TorrentResults{{Title: "Movie"}}, -time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get() should miss on expired entry")
+	}
+}
+
+func TestNewLRUCacheDefaultsCapacity(t *testing.T) {
+	c := NewLRUCache(0)
+	if c.capacity != DefaultLRUCacheCapacity {
+		t.Errorf("capacity = %d, want DefaultLRUCacheCapacity (%d)", c.capacity, DefaultLRUCacheCapacity)
+	}
+}
+
+func TestLRUCacheEvictsOldest(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", TorrentResults{{Title: "A"}}, time.Minute)
+	c.Set("b", TorrentResults{{Title: "B"}}, time.Minute)
+	c.Set("c", TorrentResults{{Title: "C"}}, time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(\"a\") should have been evicted")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("Get(\"b\") should still be present")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Error("Get(\"c\") should still be present")
+	}
+}
+
+func TestAPIFingerprintStableAcrossCallOrder(t *testing.T) {
+	a := new(API)
+	a.SearchString("test").Category(1).Category(2).Limit(25)
+
+	b := new(API)
+	b.Category(2).Limit(25).SearchString("test").Category(1)
+
+	if got, want := a.fingerprint(), b.fingerprint(); got != want {
+		t.Errorf("fingerprint() differs for equivalent queries built in different order: %s != %s", got, want)
+	}
+}
+
+func TestAPIFingerprintDiffersOnSearchString(t *testing.T) {
+	a := new(API)
+	a.SearchString("test")
+	b := new(API)
+	b.SearchString("other")
+	if a.fingerprint() == b.fingerprint() {
+		t.Error("fingerprint() should differ for different search strings")
+	}
+}
+
+func TestWithCacheShortCircuitsRepeatedSearches(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("get_token") != "" {
+			fmt.Fprint(w, `{"token": "some_token"}`)
+			return
+		}
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		fmt.Fprint(w, `{"torrent_results": [{"title": "Movie"}]}`)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	defer ts.Close()
+
+	a, err := New("test", APIURL(ts.URL), RequestDelay(0), WithCache(NewLRUCache(0)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := a.SearchString("same query").Search(); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if _, err := a.SearchString("same query").Search(); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("server got %d requests for two identical searches, want 1 (second should hit the cache)", calls)
+	}
+
+	if _, err := a.SearchString("different query").Search(); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server got %d requests after a differing search, want 2 (cache should have missed)", calls)
+	}
+}
@@ -0,0 +1,42 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQBittorrentAdd(t *testing.T) {
+	var gotURLs, gotCategory string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			http.SetCookie(w, &http.Cookie{Name: "SID", Value: "test"})
+			w.Write([]byte("Ok."))
+		case "/api/v2/torrents/add":
+			r.ParseForm()
+			gotURLs = r.FormValue("urls")
+			gotCategory = r.FormValue("category")
+			w.Write([]byte("Ok."))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	q, err := NewQBittorrent(ts.URL, "admin", "secret")
+	if err != nil {
+		t.Fatalf("NewQBittorrent() error = %v", err)
+	}
+	err = q.Add(context.Background(), "magnet:?xt=urn:btih:abc", AddOptions{Category: "movies"})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if gotURLs != "magnet:?xt=urn:btih:abc" {
+		t.Errorf("urls = %q", gotURLs)
+	}
+	if gotCategory != "movies" {
+		t.Errorf("category = %q", gotCategory)
+	}
+}
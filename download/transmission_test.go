@@ -0,0 +1,33 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransmissionAddHandshake(t *testing.T) {
+	cnt := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cnt++
+		if cnt == 1 {
+			w.Header().Set("X-Transmission-Session-Id", "test-session")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		if got := r.Header.Get("X-Transmission-Session-Id"); got != "test-session" {
+			t.Errorf("second request missing session id header, got %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	tr := NewTransmission(ts.URL)
+	if err := tr.Add(context.Background(), "magnet:?xt=urn:btih:abc", AddOptions{}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if cnt != 2 {
+		t.Errorf("server got %d requests, want 2", cnt)
+	}
+}
@@ -0,0 +1,164 @@
+package torrentapi
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ReleaseInfo is release-name metadata parsed out of a torrent title:
+// resolution, source and codec, plus whether it looks like a camrip.
+type ReleaseInfo struct {
+	Resolution string
+	Source     string
+	Codec      string
+	CAM        bool
+}
+
+var (
+	nonAlphanumericRe = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+	resolutionRe      = regexp.MustCompile(`(?i)\b(720p|1080p|2160p)\b`)
+	sourceRe          = regexp.MustCompile(`(?i)\b(WEB-?DL|BluRay|HDTV)\b`)
+	codecRe           = regexp.MustCompile(`(?i)\b(x264|x265|h ?264|h ?265|HEVC)\b`)
+
+	// camWords are camrip variants matched whole-word (after collapsing
+	// non-alphanumerics to spaces) against the title.
+	camWords = []string{
+		"CAMRip", "CAM-Rip", "HDCAM", "TS", "TSRip", "HDTS", "TELESYNC",
+		"PDVD", "PreDVDRip", "TC", "HDTC", "TELECINE", "WP", "WORKPRINT",
+	}
+)
+
+// normalizeTitle collapses runs of non-alphanumeric characters to a single
+// space, so hyphenated/dotted release tags become plain words.
+func normalizeTitle(title string) string {
+	return nonAlphanumericRe.ReplaceAllString(title, " ")
+}
+
+// ParseRelease extracts resolution, source and codec tags from a release
+// title, and flags it as a camrip if it contains one of the known camrip
+// variants as a whole word.
+func ParseRelease(title string) ReleaseInfo {
+	var info ReleaseInfo
+	if m := resolutionRe.FindString(title); m != "" {
+		info.Resolution = strings.ToLower(m)
+	}
+	if m := sourceRe.FindString(title); m != "" {
+		info.Source = m
+	}
+	if m := codecRe.FindString(title); m != "" {
+		info.Codec = strings.ToUpper(strings.ReplaceAll(m, " ", ""))
+	}
+	info.CAM = isCAMRip(title)
+	return info
+}
+
+// isCAMRip reports whether title contains a known camrip variant as a
+// whole word, matched case-insensitively after normalizeTitle.
+func isCAMRip(title string) bool {
+	norm := " " + strings.ToUpper(normalizeTitle(title)) + " "
+	for _, w := range camWords {
+		needle := " " + strings.ToUpper(normalizeTitle(w)) + " "
+		if strings.Contains(norm, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter keeps only the results for which keep returns true.
+func (r TorrentResults) filter(keep func(TorrentResult) bool) TorrentResults {
+	out := make(TorrentResults, 0, len(r))
+	for _, t := range r {
+		if keep(t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// FilterResolution keeps only results whose title matches one of the given
+// resolutions, e.g. "720p", "1080p", "2160p".
+func (r TorrentResults) FilterResolution(resolutions ...string) TorrentResults {
+	want := make(map[string]bool, len(resolutions))
+	for _, res := range resolutions {
+		want[strings.ToLower(res)] = true
+	}
+	return r.filter(func(t TorrentResult) bool {
+		return want[ParseRelease(t.Title).Resolution]
+	})
+}
+
+// FilterSizeRange keeps only results whose Size falls within [min, max].
+func (r TorrentResults) FilterSizeRange(min, max uint64) TorrentResults {
+	return r.filter(func(t TorrentResult) bool {
+		return t.Size >= min && t.Size <= max
+	})
+}
+
+// ExcludeCAM drops results that look like camrips (CAMRip, TS, TELESYNC,
+// workprints, etc).
+func (r TorrentResults) ExcludeCAM() TorrentResults {
+	return r.filter(func(t TorrentResult) bool {
+		return !ParseRelease(t.Title).CAM
+	})
+}
+
+func containsAny(title string, substrings []string) bool {
+	lower := strings.ToLower(title)
+	for _, s := range substrings {
+		if strings.Contains(lower, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAll(title string, substrings []string) bool {
+	lower := strings.ToLower(title)
+	for _, s := range substrings {
+		if !strings.Contains(lower, strings.ToLower(s)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExcludeContaining drops results whose title contains any of substrings
+// (case-insensitive).
+func (r TorrentResults) ExcludeContaining(substrings ...string) TorrentResults {
+	return r.filter(func(t TorrentResult) bool {
+		return !containsAny(t.Title, substrings)
+	})
+}
+
+// RequireContaining keeps only results whose title contains every one of
+// substrings (case-insensitive).
+func (r TorrentResults) RequireContaining(substrings ...string) TorrentResults {
+	return r.filter(func(t TorrentResult) bool {
+		return containsAll(t.Title, substrings)
+	})
+}
+
+// PreferCodecs stably reorders results so titles matching an earlier codec
+// in codecs sort before ones matching a later codec; titles matching none
+// of codecs keep their relative order after all of the preferred ones.
+func (r TorrentResults) PreferCodecs(codecs ...string) TorrentResults {
+	rank := make(map[string]int, len(codecs))
+	for i, c := range codecs {
+		rank[strings.ToUpper(c)] = i
+	}
+	out := make(TorrentResults, len(r))
+	copy(out, r)
+	sort.SliceStable(out, func(i, j int) bool {
+		return codecRank(out[i].Title, rank) < codecRank(out[j].Title, rank)
+	})
+	return out
+}
+
+func codecRank(title string, rank map[string]int) int {
+	if r, ok := rank[ParseRelease(title).Codec]; ok {
+		return r
+	}
+	return len(rank)
+}
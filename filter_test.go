@@ -0,0 +1,115 @@
+package torrentapi
+
+import "testing"
+
+func TestParseRelease(t *testing.T) {
+	testData := []struct {
+		desc  string
+		title string
+		want  ReleaseInfo
+	}{
+		{
+			desc:  "1080p WEB-DL x264",
+			title: "Movie.Name.2020.1080p.WEB-DL.x264-GROUP",
+			want:  ReleaseInfo{Resolution: "1080p", Source: "WEB-DL", Codec: "X264"},
+		},
+		{
+			desc:  "2160p BluRay HEVC",
+			title: "Movie.Name.2020.2160p.BluRay.HEVC-GROUP",
+			want:  ReleaseInfo{Resolution: "2160p", Source: "BluRay", Codec: "HEVC", CAM: false},
+		},
+		{
+			desc:  "CAMRip detected",
+			title: "Movie.Name.2020.CAMRip.XVID-GROUP",
+			want:  ReleaseInfo{CAM: true},
+		},
+		{
+			desc:  "hyphenated CAM-Rip detected",
+			title: "Movie Name 2020 CAM-Rip",
+			want:  ReleaseInfo{CAM: true},
+		},
+		{
+			desc:  "TS as whole word detected",
+			title: "Movie.Name.2020.TS.XVID-GROUP",
+			want:  ReleaseInfo{CAM: true},
+		},
+		{
+			desc:  "not a false positive on substring",
+			title: "Movie.Name.TSUNAMI.2020.1080p.WEB-DL.x264-GROUP",
+			want:  ReleaseInfo{Resolution: "1080p", Source: "WEB-DL", Codec: "X264"},
+		},
+	}
+	for i, tc := range testData {
+		got := ParseRelease(tc.title)
+		if got != tc.want {
+			t.Errorf("Test(%d) %s: ParseRelease(%q) = %+v, want %+v", i, tc.desc, tc.title, got, tc.want)
+		}
+	}
+}
+
+func TestFilterResolution(t *testing.T) {
+	results := TorrentResults{
+		{Title: "Movie.720p.WEB-DL.x264"},
+		{Title: "Movie.1080p.WEB-DL.x264"},
+		{Title: "Movie.2160p.BluRay.HEVC"},
+	}
+	got := results.FilterResolution("1080p", "2160p")
+	if len(got) != 2 {
+		t.Fatalf("FilterResolution() got %d results, want 2", len(got))
+	}
+}
+
+func TestFilterSizeRange(t *testing.T) {
+	results := TorrentResults{
+		{Title: "small", Size: 100},
+		{Title: "in range", Size: 500},
+		{Title: "too big", Size: 5000},
+	}
+	got := results.FilterSizeRange(200, 1000)
+	if len(got) != 1 || got[0].Title != "in range" {
+		t.Errorf("FilterSizeRange() = %+v, want only \"in range\"", got)
+	}
+}
+
+func TestExcludeCAM(t *testing.T) {
+	results := TorrentResults{
+		{Title: "Movie.1080p.WEB-DL.x264"},
+		{Title: "Movie.HDCAM.x264"},
+		{Title: "Movie.TELESYNC.x264"},
+	}
+	got := results.ExcludeCAM()
+	if len(got) != 1 || got[0].Title != "Movie.1080p.WEB-DL.x264" {
+		t.Errorf("ExcludeCAM() = %+v, want only the WEB-DL release", got)
+	}
+}
+
+func TestExcludeContaining(t *testing.T) {
+	results := TorrentResults{{Title: "Movie.FRENCH"}, {Title: "Movie.ENGLISH"}}
+	got := results.ExcludeContaining("french")
+	if len(got) != 1 || got[0].Title != "Movie.ENGLISH" {
+		t.Errorf("ExcludeContaining() = %+v", got)
+	}
+}
+
+func TestRequireContaining(t *testing.T) {
+	results := TorrentResults{
+		{Title: "Movie.1080p.WEB-DL"},
+		{Title: "Movie.1080p.BluRay"},
+	}
+	got := results.RequireContaining("1080p", "web-dl")
+	if len(got) != 1 || got[0].Title != "Movie.1080p.WEB-DL" {
+		t.Errorf("RequireContaining() = %+v", got)
+	}
+}
+
+func TestPreferCodecs(t *testing.T) {
+	results := TorrentResults{
+		{Title: "Movie.x264"},
+		{Title: "Movie.HEVC"},
+		{Title: "Movie.x265"},
+	}
+	got := results.PreferCodecs("HEVC", "x265")
+	if got[0].Title != "Movie.HEVC" {
+		t.Errorf("PreferCodecs() first = %q, want Movie.HEVC", got[0].Title)
+	}
+}
@@ -0,0 +1,40 @@
+package torrentapi
+
+import "context"
+
+// Query is an indexer-agnostic set of search parameters consumed by the
+// Indexer interface. Backends map the fields they understand onto their own
+// wire format and ignore the rest.
+type Query struct {
+	SearchString string
+	IMDbID       string
+	TVDBID       string
+	TheMovieDbID string
+	Categories   []int
+	Limit        int
+	MinSeeders   int
+	MinLeechers  int
+	Sort         string
+	Ranked       bool
+}
+
+// Capabilities describes what an Indexer backend supports, so callers (and
+// MultiIndexer) can decide which backends are worth querying for a given
+// Query.
+type Capabilities struct {
+	Name             string
+	SearchIMDb       bool
+	SearchTVDB       bool
+	SearchTheMovieDb bool
+}
+
+// Indexer is implemented by backends that can search for and list torrents.
+// RARBGIndexer and TorznabIndexer are the built-in implementations.
+type Indexer interface {
+	// Search performs a search for the given Query.
+	Search(ctx context.Context, q Query) (TorrentResults, error)
+	// List lists the newest torrents, optionally narrowed by Query.
+	List(ctx context.Context, q Query) (TorrentResults, error)
+	// Capabilities describes what this backend supports.
+	Capabilities() Capabilities
+}
@@ -0,0 +1,151 @@
+package torrentapi
+
+import "context"
+
+// DefaultCategories lists the RARBG category codes SearchAll partitions a
+// query across when the caller's Query doesn't set Categories. TorrentAPI
+// caps every response at limit and exposes no offset or cursor, so a
+// repeated call to the same, unscoped query is idempotent and cannot
+// surface anything beyond that first page. Splitting the same search across
+// every known category instead gives each partition its own independent
+// cap, which is the only way SearchAll can return more than a single page's
+// worth of results for a query that would otherwise go out uncategorized
+// (e.g. a TVDB search used to backfill a media library).
+var DefaultCategories = []int{
+	4,  // XXX
+	14, // Movies/XVID
+	17, // Movies/x264/1080p
+	18, // TV Episodes
+	23, // Music/MP3
+	25, // Music/FLAC
+	27, // Games/PC ISO
+	32, // Games/PS3
+	35, // Apps/PC ISO
+	41, // TV HD Episodes
+	42, // Movies/x264/4k
+	44, // Movies/x264/720p
+	45, // Movies/x264/3D
+	46, // Movies/Full BD
+	47, // Movies/BD Remux
+	48, // Games/PS4
+	50, // Movies/x265/1080p
+	52, // Movies/x265/4k/HDR
+}
+
+// ResultIterator streams TorrentResults across paginated queries.
+//
+// TorrentAPI caps limit at 100 and has no true offset/cursor, so a query is
+// idempotent: re-issuing it unchanged only ever returns the same top-N
+// results. ResultIterator therefore pages by partitioning the query across
+// categories — q.Categories if the caller set any, or DefaultCategories
+// otherwise — issuing exactly one request per category and deduplicating by
+// info hash across them. It stops once a caller-supplied cap is reached,
+// every category has been queried, or ctx is done.
+type ResultIterator struct {
+	ctx        context.Context
+	api        *API
+	query      Query
+	categories []int
+
+	cap int
+
+	pending TorrentResults
+	current TorrentResult
+	seen    map[string]bool
+	yielded int
+	nextCat int
+	err     error
+	done    bool
+}
+
+// SearchAll returns a ResultIterator paging through q, one request per entry
+// in q.Categories, or per entry in DefaultCategories if q.Categories is
+// empty. cap <= 0 means no limit beyond exhausting the categories.
+func (a *API) SearchAll(ctx context.Context, q Query, cap int) *ResultIterator {
+	categories := q.Categories
+	if len(categories) == 0 {
+		categories = DefaultCategories
+	}
+	return &ResultIterator{
+		ctx:        ctx,
+		api:        a,
+		query:      q,
+		categories: categories,
+		cap:        cap,
+		seen:       make(map[string]bool),
+	}
+}
+
+// fetchPage issues a single request for the next not-yet-queried category
+// and buffers its deduplicated results into it.pending, skipping over
+// categories that come back empty or entirely duplicate. It reports whether
+// a non-empty page was found.
+func (it *ResultIterator) fetchPage() bool {
+	for it.nextCat < len(it.categories) {
+		cat := it.categories[it.nextCat]
+		it.nextCat++
+
+		q := it.query
+		q.Categories = []int{cat}
+		results, err := NewRARBGIndexer(it.api).Search(it.ctx, q)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+		fresh := make(TorrentResults, 0, len(results))
+		for _, r := range results {
+			if h := infoHash(r); h != "" {
+				if it.seen[h] {
+					continue
+				}
+				it.seen[h] = true
+			}
+			fresh = append(fresh, r)
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+		it.pending = fresh
+		return true
+	}
+	it.done = true
+	return false
+}
+
+// Next advances the iterator and reports whether a result is available via
+// Result. It returns false once the cap is reached, every category has
+// been exhausted, or ctx is done or an error occurred; use Err to tell an
+// error apart from ordinary exhaustion.
+func (it *ResultIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+	for len(it.pending) == 0 {
+		if !it.fetchPage() {
+			return false
+		}
+	}
+	if it.cap > 0 && it.yielded >= it.cap {
+		it.done = true
+		return false
+	}
+	it.current, it.pending = it.pending[0], it.pending[1:]
+	it.yielded++
+	return true
+}
+
+// Result returns the result produced by the most recent call to Next.
+func (it *ResultIterator) Result() TorrentResult {
+	return it.current
+}
+
+// Err returns the first error encountered while paginating, if any.
+func (it *ResultIterator) Err() error {
+	return it.err
+}
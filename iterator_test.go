@@ -0,0 +1,127 @@
+package torrentapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSearchAllPaginatesAcrossCategoriesAndDedupes(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("get_token") != "" {
+			fmt.Fprint(w, `{"token": "some_token"}`)
+			return
+		}
+		switch r.URL.Query().Get("category") {
+		case "1":
+			fmt.Fprint(w, `{"torrent_results": [
+				{"title": "A", "download": "magnet:?xt=urn:btih:AAA"},
+				{"title": "B", "download": "magnet:?xt=urn:btih:BBB"}
+			]}`)
+		case "2":
+			fmt.Fprint(w, `{"torrent_results": [
+				{"title": "B duplicate", "download": "magnet:?xt=urn:btih:bbb"},
+				{"title": "C", "download": "magnet:?xt=urn:btih:CCC"}
+			]}`)
+		default:
+			fmt.Fprint(w, `{"error": "no results", "error_code": 20}`)
+		}
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	defer ts.Close()
+
+	a, err := New("test", APIURL(ts.URL), RequestDelay(0))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	it := a.SearchAll(context.Background(), Query{Categories: []int{1, 2}}, 0)
+	var titles []string
+	for it.Next() {
+		titles = append(titles, it.Result().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("iterator error: %v", err)
+	}
+	if len(titles) != 3 {
+		t.Fatalf("got %d results, want 3 (deduped): %v", len(titles), titles)
+	}
+}
+
+func TestSearchAllPartitionsAcrossDefaultCategoriesWhenNoneSet(t *testing.T) {
+	var gotCategories []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("get_token") != "" {
+			fmt.Fprint(w, `{"token": "some_token"}`)
+			return
+		}
+		cat := r.URL.Query().Get("category")
+		gotCategories = append(gotCategories, cat)
+		switch cat {
+		case strconv.Itoa(DefaultCategories[0]):
+			fmt.Fprint(w, `{"torrent_results": [{"title": "A", "download": "magnet:?xt=urn:btih:AAA"}]}`)
+		case strconv.Itoa(DefaultCategories[1]):
+			fmt.Fprint(w, `{"torrent_results": [{"title": "B", "download": "magnet:?xt=urn:btih:BBB"}]}`)
+		default:
+			fmt.Fprint(w, `{"error": "no results", "error_code": 20}`)
+		}
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	defer ts.Close()
+
+	a, err := New("test", APIURL(ts.URL), RequestDelay(0))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// No categories set: a single-category/uncategorized query (e.g. a
+	// TVDB search) is idempotent against the real TorrentAPI, so SearchAll
+	// must get its extra coverage by partitioning across DefaultCategories
+	// rather than re-issuing the same request and hoping it changes.
+	it := a.SearchAll(context.Background(), Query{}, 0)
+	var titles []string
+	for it.Next() {
+		titles = append(titles, it.Result().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("iterator error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Fatalf("got %d results, want 2 (one per populated category): %v", len(titles), titles)
+	}
+	if len(gotCategories) != len(DefaultCategories) {
+		t.Errorf("server got %d requests, want one per entry in DefaultCategories (%d)", len(gotCategories), len(DefaultCategories))
+	}
+}
+
+func TestSearchAllRespectsCap(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("get_token") != "" {
+			fmt.Fprint(w, `{"token": "some_token"}`)
+			return
+		}
+		fmt.Fprint(w, `{"torrent_results": [
+			{"title": "A", "download": "magnet:?xt=urn:btih:AAA"},
+			{"title": "B", "download": "magnet:?xt=urn:btih:BBB"}
+		]}`)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	defer ts.Close()
+
+	a, err := New("test", APIURL(ts.URL), RequestDelay(0))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	it := a.SearchAll(context.Background(), Query{}, 1)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("got %d results, want 1 (capped)", count)
+	}
+}
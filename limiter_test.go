@@ -0,0 +1,29 @@
+package torrentapi
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDisabledWhenNonPositive(t *testing.T) {
+	l := newRateLimiter(0)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait() call %d error = %v", i, err)
+		}
+	}
+}
+
+func TestMakeRequestCancellation(t *testing.T) {
+	a := new(API)
+	a.limiter = newRateLimiter(time.Hour)
+	a.maxRetries = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := a.makeRequest(ctx, "http://127.0.0.1:0/"); err == nil {
+		t.Error("makeRequest() with cancelled context expected an error")
+	}
+}
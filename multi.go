@@ -0,0 +1,104 @@
+package torrentapi
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// MultiIndexer fans a single Query out to several Indexer backends
+// concurrently and merges the results, deduplicating by info hash where one
+// can be extracted from TorrentResult.Download.
+type MultiIndexer struct {
+	Indexers []Indexer
+}
+
+// NewMultiIndexer builds a MultiIndexer over the given backends.
+func NewMultiIndexer(indexers ...Indexer) *MultiIndexer {
+	return &MultiIndexer{Indexers: indexers}
+}
+
+var magnetHashRe = regexp.MustCompile(`(?i)xt=urn:btih:([a-z0-9]+)`)
+
+// infoHash extracts the BitTorrent info hash from a magnet link, if any.
+func infoHash(r TorrentResult) string {
+	m := magnetHashRe.FindStringSubmatch(r.Download)
+	if m == nil {
+		return ""
+	}
+	return strings.ToLower(m[1])
+}
+
+// fanOut runs fn against every backend concurrently and merges the results
+// that didn't error, deduplicating by info hash. Results without a
+// recognizable info hash are always kept.
+func (m *MultiIndexer) fanOut(fn func(Indexer) (TorrentResults, error)) (TorrentResults, error) {
+	type outcome struct {
+		results TorrentResults
+		err     error
+	}
+	outcomes := make([]outcome, len(m.Indexers))
+	var wg sync.WaitGroup
+	for i, idx := range m.Indexers {
+		wg.Add(1)
+		go func(i int, idx Indexer) {
+			defer wg.Done()
+			results, err := fn(idx)
+			outcomes[i] = outcome{results: results, err: err}
+		}(i, idx)
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool)
+	var merged TorrentResults
+	var lastErr error
+	for _, o := range outcomes {
+		if o.err != nil {
+			lastErr = o.err
+			continue
+		}
+		for _, r := range o.results {
+			if h := infoHash(r); h != "" {
+				if seen[h] {
+					continue
+				}
+				seen[h] = true
+			}
+			merged = append(merged, r)
+		}
+	}
+	if len(merged) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return merged, nil
+}
+
+// Search implements Indexer, querying every backend concurrently.
+func (m *MultiIndexer) Search(ctx context.Context, q Query) (TorrentResults, error) {
+	return m.fanOut(func(idx Indexer) (TorrentResults, error) {
+		return idx.Search(ctx, q)
+	})
+}
+
+// List implements Indexer, querying every backend concurrently.
+func (m *MultiIndexer) List(ctx context.Context, q Query) (TorrentResults, error) {
+	return m.fanOut(func(idx Indexer) (TorrentResults, error) {
+		return idx.List(ctx, q)
+	})
+}
+
+// Capabilities implements Indexer, reporting the union of what the backends
+// support.
+func (m *MultiIndexer) Capabilities() Capabilities {
+	caps := Capabilities{Name: "multi"}
+	for _, idx := range m.Indexers {
+		c := idx.Capabilities()
+		caps.SearchIMDb = caps.SearchIMDb || c.SearchIMDb
+		caps.SearchTVDB = caps.SearchTVDB || c.SearchTVDB
+		caps.SearchTheMovieDb = caps.SearchTheMovieDb || c.SearchTheMovieDb
+	}
+	return caps
+}
+
+var _ Indexer = (*MultiIndexer)(nil)
@@ -0,0 +1,67 @@
+package torrentapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeIndexer struct {
+	results TorrentResults
+	err     error
+	caps    Capabilities
+}
+
+func (f *fakeIndexer) Search(ctx context.Context, q Query) (TorrentResults, error) {
+	return f.results, f.err
+}
+
+func (f *fakeIndexer) List(ctx context.Context, q Query) (TorrentResults, error) {
+	return f.results, f.err
+}
+
+func (f *fakeIndexer) Capabilities() Capabilities {
+	return f.caps
+}
+
+func TestMultiIndexerSearchDedupes(t *testing.T) {
+	a := &fakeIndexer{results: TorrentResults{
+		{Title: "Movie A", Download: "magnet:?xt=urn:btih:SAMEHASH"},
+		{Title: "Movie B", Download: "magnet:?xt=urn:btih:OTHERHASH"},
+	}}
+	b := &fakeIndexer{results: TorrentResults{
+		{Title: "Movie A duplicate", Download: "magnet:?xt=urn:btih:samehash"},
+		{Title: "Movie C", Download: "magnet:?xt=urn:btih:THIRDHASH"},
+	}}
+	m := NewMultiIndexer(a, b)
+	got, err := m.Search(context.Background(), Query{SearchString: "movie"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Search() got %d results, want 3: %+v", len(got), got)
+	}
+}
+
+func TestMultiIndexerSearchAllError(t *testing.T) {
+	a := &fakeIndexer{err: errors.New("boom")}
+	b := &fakeIndexer{err: errors.New("also boom")}
+	m := NewMultiIndexer(a, b)
+	_, err := m.Search(context.Background(), Query{})
+	if err == nil {
+		t.Error("Search() expected error when every backend fails")
+	}
+}
+
+func TestMultiIndexerSearchPartialError(t *testing.T) {
+	a := &fakeIndexer{err: errors.New("boom")}
+	b := &fakeIndexer{results: TorrentResults{{Title: "Movie A", Download: "magnet:?xt=urn:btih:HASH"}}}
+	m := NewMultiIndexer(a, b)
+	got, err := m.Search(context.Background(), Query{})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("Search() got %d results, want 1", len(got))
+	}
+}
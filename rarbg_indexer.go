@@ -0,0 +1,69 @@
+package torrentapi
+
+import "context"
+
+// RARBGIndexer adapts the builder-style API to the Indexer interface, so
+// RARBG can be used interchangeably with other backends such as
+// TorznabIndexer.
+type RARBGIndexer struct {
+	api *API
+}
+
+// NewRARBGIndexer wraps an existing API as an Indexer.
+func NewRARBGIndexer(a *API) *RARBGIndexer {
+	return &RARBGIndexer{api: a}
+}
+
+// apply translates a Query onto the underlying builder-style API.
+func (r *RARBGIndexer) apply(q Query) {
+	a := r.api
+	if q.SearchString != "" {
+		a.SearchString(q.SearchString)
+	}
+	if q.IMDbID != "" {
+		a.SearchIMDb(q.IMDbID)
+	}
+	if q.TVDBID != "" {
+		a.SearchTVDB(q.TVDBID)
+	}
+	if q.TheMovieDbID != "" {
+		a.SearchTheMovieDb(q.TheMovieDbID)
+	}
+	for _, c := range q.Categories {
+		a.Category(c)
+	}
+	if q.Limit > 0 {
+		a.Limit(q.Limit)
+	}
+	if q.MinSeeders > 0 {
+		a.MinSeeders(q.MinSeeders)
+	}
+	if q.MinLeechers > 0 {
+		a.MinLeechers(q.MinLeechers)
+	}
+	if q.Sort != "" {
+		a.Sort(q.Sort)
+	}
+	if q.Ranked {
+		a.Ranked(true)
+	}
+}
+
+// Search implements Indexer.
+func (r *RARBGIndexer) Search(ctx context.Context, q Query) (TorrentResults, error) {
+	r.apply(q)
+	return r.api.SearchContext(ctx)
+}
+
+// List implements Indexer.
+func (r *RARBGIndexer) List(ctx context.Context, q Query) (TorrentResults, error) {
+	r.apply(q)
+	return r.api.ListContext(ctx)
+}
+
+// Capabilities implements Indexer.
+func (r *RARBGIndexer) Capabilities() Capabilities {
+	return Capabilities{Name: "rarbg", SearchIMDb: true, SearchTVDB: true, SearchTheMovieDb: true}
+}
+
+var _ Indexer = (*RARBGIndexer)(nil)
@@ -0,0 +1,52 @@
+package torrentapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryDelayHonorsRetryAfterSeconds(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": {"5"}}}
+	got := retryDelay(0, resp)
+	if got != 5*time.Second {
+		t.Errorf("retryDelay() = %v, want 5s", got)
+	}
+}
+
+func TestRetryDelayHonorsRetryAfterHTTPDate(t *testing.T) {
+	when := time.Now().Add(10 * time.Second)
+	resp := &http.Response{Header: http.Header{"Retry-After": {when.UTC().Format(http.TimeFormat)}}}
+	got := retryDelay(0, resp)
+	if got <= 0 || got > 11*time.Second {
+		t.Errorf("retryDelay() = %v, want ~10s", got)
+	}
+}
+
+func TestRetryDelayIgnoresPastRetryAfterDate(t *testing.T) {
+	when := time.Now().Add(-10 * time.Second)
+	resp := &http.Response{Header: http.Header{"Retry-After": {when.UTC().Format(http.TimeFormat)}}}
+	got := retryDelay(3, resp)
+	want := time.Duration(1<<uint(3)) * 100 * time.Millisecond
+	if got < want {
+		t.Errorf("retryDelay() = %v, want >= %v (falls back to backoff)", got, want)
+	}
+}
+
+func TestRetryDelayBacksOffWithoutRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	for attempt := 0; attempt < 4; attempt++ {
+		base := time.Duration(1<<uint(attempt)) * 100 * time.Millisecond
+		got := retryDelay(attempt, resp)
+		if got < base || got > 2*base {
+			t.Errorf("retryDelay(%d) = %v, want in [%v, %v]", attempt, got, base, 2*base)
+		}
+	}
+}
+
+func TestRetryDelayNilResponseFallsBackToBackoff(t *testing.T) {
+	got := retryDelay(0, nil)
+	if got < 100*time.Millisecond {
+		t.Errorf("retryDelay(0, nil) = %v, want >= 100ms", got)
+	}
+}
@@ -0,0 +1,67 @@
+package torrentapi
+
+import (
+	"context"
+	"math"
+)
+
+// SearchParams bundles an upstream Query with client-side constraints
+// applied to the results after they come back, so a single call returns a
+// filtered, ranked slice without the caller re-implementing release-name
+// parsing.
+type SearchParams struct {
+	Query
+
+	// Resolutions, if set, keeps only matching results (e.g. "1080p").
+	Resolutions []string
+	// MinSize and MaxSize bound TorrentResult.Size; a zero MaxSize leaves
+	// the upper end unbounded.
+	MinSize uint64
+	MaxSize uint64
+	// ExcludeCAM drops camrip/telesync/workprint releases.
+	ExcludeCAM bool
+	// Exclude drops results whose title contains any of these substrings.
+	Exclude []string
+	// Require keeps only results whose title contains all of these
+	// substrings.
+	Require []string
+	// PreferredCodecs reorders results so matching codecs sort first.
+	PreferredCodecs []string
+}
+
+// Search runs p.Query against idx and applies the client-side constraints
+// to the results.
+func (p SearchParams) Search(ctx context.Context, idx Indexer) (TorrentResults, error) {
+	results, err := idx.Search(ctx, p.Query)
+	if err != nil {
+		return nil, err
+	}
+	return p.apply(results), nil
+}
+
+// apply runs the configured filters over results, in the order a caller
+// would naturally narrow a result set: resolution, then size, then
+// quality exclusions, then content requirements, then codec preference.
+func (p SearchParams) apply(results TorrentResults) TorrentResults {
+	if len(p.Resolutions) > 0 {
+		results = results.FilterResolution(p.Resolutions...)
+	}
+	if p.MaxSize > 0 {
+		results = results.FilterSizeRange(p.MinSize, p.MaxSize)
+	} else if p.MinSize > 0 {
+		results = results.FilterSizeRange(p.MinSize, math.MaxUint64)
+	}
+	if p.ExcludeCAM {
+		results = results.ExcludeCAM()
+	}
+	if len(p.Exclude) > 0 {
+		results = results.ExcludeContaining(p.Exclude...)
+	}
+	if len(p.Require) > 0 {
+		results = results.RequireContaining(p.Require...)
+	}
+	if len(p.PreferredCodecs) > 0 {
+		results = results.PreferCodecs(p.PreferredCodecs...)
+	}
+	return results
+}
@@ -0,0 +1,27 @@
+package torrentapi
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSearchParamsSearch(t *testing.T) {
+	idx := &fakeIndexer{results: TorrentResults{
+		{Title: "Movie.1080p.WEB-DL.x264", Size: 2 << 30},
+		{Title: "Movie.HDCAM.x264", Size: 1 << 30},
+		{Title: "Movie.2160p.BluRay.x265", Size: 8 << 30},
+	}}
+	p := SearchParams{
+		Query:       Query{SearchString: "movie"},
+		Resolutions: []string{"1080p", "2160p"},
+		MaxSize:     4 << 30,
+		ExcludeCAM:  true,
+	}
+	got, err := p.Search(context.Background(), idx)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Title != "Movie.1080p.WEB-DL.x264" {
+		t.Errorf("Search() = %+v, want only the 1080p WEB-DL result", got)
+	}
+}
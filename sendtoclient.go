@@ -0,0 +1,24 @@
+package torrentapi
+
+import (
+	"context"
+
+	"github.com/qopher/go-torrentapi/download"
+)
+
+// SendToClient pushes every result's magnet/download link to dl, using opts
+// for category/tag/savepath/paused. It keeps going past individual
+// failures and returns the first error encountered, if any, so one bad
+// result doesn't block the rest of the batch.
+func (a *API) SendToClient(ctx context.Context, results TorrentResults, dl download.Downloader, opts download.AddOptions) error {
+	var firstErr error
+	for _, r := range results {
+		if r.Download == "" {
+			continue
+		}
+		if err := dl.Add(ctx, r.Download, opts); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
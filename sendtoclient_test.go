@@ -0,0 +1,47 @@
+package torrentapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/qopher/go-torrentapi/download"
+)
+
+type fakeDownloader struct {
+	added []string
+	err   error
+}
+
+func (f *fakeDownloader) Add(ctx context.Context, url string, opts download.AddOptions) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.added = append(f.added, url)
+	return nil
+}
+
+func TestSendToClient(t *testing.T) {
+	results := TorrentResults{
+		{Title: "Movie A", Download: "magnet:?xt=urn:btih:a"},
+		{Title: "Movie B", Download: ""},
+		{Title: "Movie C", Download: "magnet:?xt=urn:btih:c"},
+	}
+	dl := &fakeDownloader{}
+	a := new(API)
+	if err := a.SendToClient(context.Background(), results, dl, download.AddOptions{}); err != nil {
+		t.Fatalf("SendToClient() error = %v", err)
+	}
+	if len(dl.added) != 2 {
+		t.Fatalf("SendToClient() added %d torrents, want 2", len(dl.added))
+	}
+}
+
+func TestSendToClientReturnsFirstError(t *testing.T) {
+	results := TorrentResults{{Title: "Movie A", Download: "magnet:?xt=urn:btih:a"}}
+	dl := &fakeDownloader{err: errors.New("boom")}
+	a := new(API)
+	if err := a.SendToClient(context.Background(), results, dl, download.AddOptions{}); err == nil {
+		t.Error("SendToClient() expected error from downloader")
+	}
+}
@@ -2,14 +2,21 @@
 package torrentapi
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -101,6 +108,34 @@ type API struct {
 	tokenExpiration time.Duration
 	url             string
 	maxRetries      int
+	limiter         Limiter
+	cache           Cache
+	cacheTTL        time.Duration
+}
+
+// Limiter controls the pace of outbound requests to the upstream API. It is
+// consulted before every request makeRequest sends, including retries.
+type Limiter interface {
+	// Wait blocks until a request is allowed to proceed, or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// rateLimiter adapts golang.org/x/time/rate to the Limiter interface.
+type rateLimiter struct {
+	l *rate.Limiter
+}
+
+// newRateLimiter builds a Limiter allowing one request per d. A non-positive
+// d disables limiting entirely, which existing callers rely on in tests.
+func newRateLimiter(d time.Duration) *rateLimiter {
+	if d <= 0 {
+		return &rateLimiter{l: rate.NewLimiter(rate.Inf, 1)}
+	}
+	return &rateLimiter{l: rate.NewLimiter(rate.Every(d), 1)}
+}
+
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	return r.l.Wait(ctx)
 }
 
 // SearchString adds search string to search query.
@@ -175,19 +210,31 @@ func (a *API) MinLeechers(minLeech int) *API {
 
 // List lists the newest torrrents, this has to be last function in chain.
 func (a *API) List() (TorrentResults, error) {
+	return a.ListContext(context.Background())
+}
+
+// ListContext is List with a context.Context that can abort an in-flight
+// request or retry loop, e.g. when the caller disconnects.
+func (a *API) ListContext(ctx context.Context) (TorrentResults, error) {
 	a.Query += "&mode=list"
-	return a.call()
+	return a.callCtx(ctx)
 }
 
 // Search performs search, this has to be last function in chain.
 func (a *API) Search() (TorrentResults, error) {
+	return a.SearchContext(context.Background())
+}
+
+// SearchContext is Search with a context.Context that can abort an in-flight
+// request or retry loop, e.g. when the caller disconnects.
+func (a *API) SearchContext(ctx context.Context) (TorrentResults, error) {
 	a.Query += "&mode=search"
-	return a.call()
+	return a.callCtx(ctx)
 }
 
 // getResults sends query to TorrentAPI and fetch the response.
-func (a *API) getResults(query string) (*APIResponse, error) {
-	resp, err := a.makeRequest(query)
+func (a *API) getResults(ctx context.Context, query string) (*APIResponse, error) {
+	resp, err := a.makeRequest(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -199,10 +246,27 @@ func (a *API) getResults(query string) (*APIResponse, error) {
 
 // call calls API and processes response.
 func (a *API) call() (TorrentResults, error) {
+	return a.callCtx(context.Background())
+}
+
+// callCtx is call with a context.Context threaded through the token renewal
+// and request retry loop.
+func (a *API) callCtx(ctx context.Context) (data TorrentResults, err error) {
 	defer a.initQuery()
+	var cacheKey string
+	if a.cache != nil {
+		cacheKey = a.fingerprint()
+		if cached, ok := a.cache.Get(cacheKey); ok {
+			return cached, nil
+		}
+		defer func() {
+			if err == nil {
+				a.cache.Set(cacheKey, data, a.cacheTTL)
+			}
+		}()
+	}
 	if !a.APIToken.IsValid() {
-		var err error
-		a.APIToken, err = a.renewToken()
+		a.APIToken, err = a.renewToken(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -215,28 +279,57 @@ func (a *API) call() (TorrentResults, error) {
 		a.Query += fmt.Sprintf("&category=%s", strings.Join(categories, ";"))
 	}
 	query := fmt.Sprintf("%s&token=%s%s&app_id=%s", a.url, a.APIToken.Token, a.Query, a.appID)
-	apiResponse, err := a.getResults(query)
+	apiResponse, err := a.getResults(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	data, err := a.processResponse(apiResponse)
+	data, err = a.processResponse(apiResponse)
 	if err != nil {
 		if _, ok := err.(*expiredTokenError); ok {
 			// Token expired, renew it and try again
-			a.APIToken, err = a.renewToken()
+			a.APIToken, err = a.renewToken(ctx)
 			if err != nil {
 				return nil, err
 			}
-			apiResponse, err = a.getResults(query)
+			apiResponse, err = a.getResults(ctx, query)
 			if err != nil {
 				return nil, err
 			}
-			return a.processResponse(apiResponse)
+			data, err = a.processResponse(apiResponse)
+			return data, err
 		}
 	}
 	return data, err
 }
 
+// fingerprint computes a stable cache key for the currently composed query:
+// search terms, category set, format, limit, sort, min_seeders/leechers and
+// mode, independent of the order builder methods were called in.
+func (a *API) fingerprint() string {
+	values, _ := url.ParseQuery(strings.TrimPrefix(a.Query, "&"))
+	categories := make([]string, len(a.categories))
+	for i, c := range a.categories {
+		categories[i] = strconv.Itoa(c)
+	}
+	sort.Strings(categories)
+	parts := strings.Join([]string{
+		"search_string=" + values.Get("search_string"),
+		"search_imdb=" + values.Get("search_imdb"),
+		"search_tvdb=" + values.Get("search_tvdb"),
+		"search_themoviedb=" + values.Get("search_themoviedb"),
+		"format=" + values.Get("format"),
+		"limit=" + values.Get("limit"),
+		"sort=" + values.Get("sort"),
+		"ranked=" + values.Get("ranked"),
+		"min_seeders=" + values.Get("min_seeders"),
+		"min_leechers=" + values.Get("min_leechers"),
+		"category=" + strings.Join(categories, ","),
+		"mode=" + values.Get("mode"),
+	}, "&")
+	sum := sha256.Sum256([]byte(parts))
+	return hex.EncodeToString(sum[:])
+}
+
 type expiredTokenError struct {
 	s string
 }
@@ -281,9 +374,9 @@ func (a *API) initQuery() {
 }
 
 // RenewToken fetches new token.
-func (a *API) renewToken() (Token, error) {
+func (a *API) renewToken(ctx context.Context) (Token, error) {
 	var token Token
-	resp, err := a.makeRequest(a.url + fmt.Sprintf("get_token=get_token&app_id=%s", a.appID))
+	resp, err := a.makeRequest(ctx, a.url+fmt.Sprintf("get_token=get_token&app_id=%s", a.appID))
 	if err != nil {
 		return token, err
 	}
@@ -295,12 +388,39 @@ func (a *API) renewToken() (Token, error) {
 	return token, nil
 }
 
-// makeRequest performs request with the provided query.
-func (a *API) makeRequest(query string) (*http.Response, error) {
+// retryDelay picks how long to wait before the next attempt. It honors a
+// Retry-After header on resp when present, and otherwise falls back to
+// exponential backoff with jitter based on the attempt number.
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if ra := resp.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+			if t, err := http.ParseTime(ra); err == nil {
+				if d := time.Until(t); d > 0 {
+					return d
+				}
+			}
+		}
+	}
+	base := time.Duration(1<<uint(attempt)) * 100 * time.Millisecond
+	return base + time.Duration(rand.Int63n(int64(base)+1))
+}
+
+// makeRequest performs request with the provided query, pacing calls
+// through the configured Limiter and retrying on 429/503 responses with
+// exponential backoff (or the server-provided Retry-After), making at most
+// maxRetries attempts in all.
+func (a *API) makeRequest(ctx context.Context, query string) (*http.Response, error) {
 	maxAttempts := a.maxRetries
+	attempt := 0
 	for {
 		maxAttempts--
-		req, err := http.NewRequest("GET", query, nil)
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, "GET", query, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create http request: %v", err)
 		}
@@ -312,11 +432,19 @@ func (a *API) makeRequest(query string) (*http.Response, error) {
 		switch st := resp.StatusCode; st {
 		case http.StatusOK:
 			return resp, nil
-		case http.StatusTooManyRequests:
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
 			if maxAttempts > 0 {
-				time.Sleep(a.reqDelay)
-				continue
+				delay := retryDelay(attempt, resp)
+				resp.Body.Close()
+				attempt++
+				select {
+				case <-time.After(delay):
+					continue
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
 			}
+			resp.Body.Close()
 			return nil, errors.New("maximum number of attempts reached")
 		default:
 			return nil, fmt.Errorf("non 200-OK respose: Code(%d) Status(%s)", resp.StatusCode, resp.Status)
@@ -364,6 +492,33 @@ func MaxRetries(r int) Option {
 	})
 }
 
+// WithLimiter overrides the default Limiter used to pace outbound requests.
+// When not set, New builds one from RequestDelay honoring the historical
+// TorrentAPI limit of 1 request / 2s.
+func WithLimiter(l Limiter) Option {
+	return option(func(a *API) {
+		a.limiter = l
+	})
+}
+
+// WithCache enables short-circuiting repeated searches within CacheTTL,
+// keyed by a fingerprint of the composed query. Pass NewLRUCache for an
+// in-memory default, or any type satisfying Cache (e.g. backed by Redis or
+// BoltDB) for shared/persistent caching across processes.
+func WithCache(c Cache) Option {
+	return option(func(a *API) {
+		a.cache = c
+	})
+}
+
+// CacheTTL sets how long cached results stay fresh. Only meaningful when
+// WithCache is also set; defaults to DefaultCacheTTL.
+func CacheTTL(d time.Duration) Option {
+	return option(func(a *API) {
+		a.cacheTTL = d
+	})
+}
+
 // Init Initializes API object, fetches new token and returns API instance.
 func New(appID string, opts ...Option) (*API, error) {
 	a := &API{
@@ -373,14 +528,18 @@ func New(appID string, opts ...Option) (*API, error) {
 		url:             DefaultAPIURL,
 		maxRetries:      DefaultMaxRetries,
 		tokenExpiration: DefaultTokenExpiration,
+		cacheTTL:        DefaultCacheTTL,
 	}
 	for _, o := range opts {
 		o.set(a)
 	}
+	if a.limiter == nil {
+		a.limiter = newRateLimiter(a.reqDelay)
+	}
 	if !strings.HasSuffix(a.url, "?") {
 		a.url += "?"
 	}
-	token, err := a.renewToken()
+	token, err := a.renewToken(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("error renewing token: %v", err)
 	}
@@ -0,0 +1,178 @@
+package torrentapi
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// TorznabIndexer implements Indexer against a Torznab-compatible endpoint,
+// such as Jackett or Prowlarr, or any self-hosted indexer speaking the same
+// protocol.
+type TorznabIndexer struct {
+	client *http.Client
+	// BaseURL is the Torznab "api" endpoint, e.g.
+	// "http://localhost:9117/api/v2.0/indexers/all/results/torznab/api".
+	BaseURL string
+	// APIKey is sent as apikey= on every request.
+	APIKey string
+	// Name identifies the backend in Capabilities, defaults to "torznab".
+	Name string
+}
+
+// NewTorznabIndexer creates a TorznabIndexer for the given Torznab endpoint
+// and API key.
+func NewTorznabIndexer(baseURL, apiKey string) *TorznabIndexer {
+	return &TorznabIndexer{
+		client:  &http.Client{},
+		BaseURL: baseURL,
+		APIKey:  apiKey,
+		Name:    "torznab",
+	}
+}
+
+// torznabRSS is the XML envelope a Torznab endpoint responds with.
+type torznabRSS struct {
+	Channel struct {
+		Items []torznabItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type torznabItem struct {
+	Title   string `xml:"title"`
+	Link    string `xml:"link"`
+	PubDate string `xml:"pubDate"`
+	Size    uint64 `xml:"size"`
+	Attrs   []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	} `xml:"attr"`
+}
+
+// attr returns the value of the named torznab:attr element, if present.
+func (i torznabItem) attr(name string) string {
+	for _, a := range i.Attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// toResult converts a Torznab <item> into the shared TorrentResult shape.
+func (i torznabItem) toResult() TorrentResult {
+	seeders, _ := strconv.Atoi(i.attr("seeders"))
+	leechers, _ := strconv.Atoi(i.attr("peers"))
+	size := i.Size
+	if size == 0 {
+		size, _ = strconv.ParseUint(i.attr("size"), 10, 64)
+	}
+	download := i.attr("magneturl")
+	if download == "" {
+		download = i.Link
+	}
+	return TorrentResult{
+		Title:    i.Title,
+		Download: download,
+		Seeders:  seeders,
+		Leechers: leechers,
+		Size:     size,
+		PubDate:  i.PubDate,
+		InfoPage: i.Link,
+		EpisodeInfo: EpisodeInfo{
+			ImDB:   i.attr("imdb"),
+			TvDB:   i.attr("tvdbid"),
+			TvRage: i.attr("rageid"),
+		},
+	}
+}
+
+// buildQuery maps a Query onto the Torznab query parameters for the given
+// search mode (search, tvsearch or movie).
+func (t *TorznabIndexer) buildQuery(mode string, q Query) string {
+	v := url.Values{}
+	v.Set("t", mode)
+	v.Set("apikey", t.APIKey)
+	if q.SearchString != "" {
+		v.Set("q", q.SearchString)
+	}
+	if q.IMDbID != "" {
+		v.Set("imdbid", strings.TrimPrefix(q.IMDbID, "tt"))
+	}
+	if q.TVDBID != "" {
+		v.Set("tvdbid", q.TVDBID)
+	}
+	if len(q.Categories) > 0 {
+		cats := make([]string, len(q.Categories))
+		for i, c := range q.Categories {
+			cats[i] = strconv.Itoa(c)
+		}
+		v.Set("cat", strings.Join(cats, ","))
+	}
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	return t.BaseURL + "?" + v.Encode()
+}
+
+// mode picks the Torznab search mode based on the populated Query fields.
+func mode(q Query) string {
+	switch {
+	case q.TVDBID != "":
+		return "tvsearch"
+	case q.IMDbID != "":
+		return "movie"
+	default:
+		return "search"
+	}
+}
+
+// fetch issues the Torznab request and parses the RSS response.
+func (t *TorznabIndexer) fetch(ctx context.Context, rawURL string) (TorrentResults, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request: %v", err)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("non 200-OK response: Code(%d) Status(%s)", resp.StatusCode, resp.Status)
+	}
+	var rss torznabRSS
+	if err := xml.NewDecoder(resp.Body).Decode(&rss); err != nil {
+		return nil, fmt.Errorf("error decoding torznab response: %v", err)
+	}
+	results := make(TorrentResults, len(rss.Channel.Items))
+	for i, item := range rss.Channel.Items {
+		results[i] = item.toResult()
+	}
+	return results, nil
+}
+
+// Search implements Indexer.
+func (t *TorznabIndexer) Search(ctx context.Context, q Query) (TorrentResults, error) {
+	return t.fetch(ctx, t.buildQuery(mode(q), q))
+}
+
+// List implements Indexer.
+func (t *TorznabIndexer) List(ctx context.Context, q Query) (TorrentResults, error) {
+	return t.fetch(ctx, t.buildQuery("search", q))
+}
+
+// Capabilities implements Indexer.
+func (t *TorznabIndexer) Capabilities() Capabilities {
+	name := t.Name
+	if name == "" {
+		name = "torznab"
+	}
+	return Capabilities{Name: name, SearchIMDb: true, SearchTVDB: true}
+}
+
+var _ Indexer = (*TorznabIndexer)(nil)
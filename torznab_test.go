@@ -0,0 +1,91 @@
+package torrentapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func torznabFeed(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<rss>
+<channel>
+<item>
+<title>Movie.2020.1080p.WEB-DL.x264</title>
+<link>http://example.com/details/1</link>
+<pubDate>Mon, 01 Jan 2020 00:00:00 +0000</pubDate>
+<torznab:attr name="seeders" value="12" />
+<torznab:attr name="peers" value="3" />
+<torznab:attr name="size" value="1073741824" />
+<torznab:attr name="magneturl" value="magnet:?xt=urn:btih:abc123" />
+<torznab:attr name="imdb" value="tt1234567" />
+</item>
+</channel>
+</rss>`)
+}
+
+func TestTorznabIndexerSearch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(torznabFeed))
+	defer ts.Close()
+
+	idx := NewTorznabIndexer(ts.URL, "testkey")
+	results, err := idx.Search(context.Background(), Query{SearchString: "movie"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Search() got %d results, want 1", len(results))
+	}
+	got := results[0]
+	if got.Title != "Movie.2020.1080p.WEB-DL.x264" {
+		t.Errorf("Title = %q", got.Title)
+	}
+	if got.Seeders != 12 || got.Leechers != 3 {
+		t.Errorf("Seeders/Leechers = %d/%d, want 12/3", got.Seeders, got.Leechers)
+	}
+	if got.Size != 1073741824 {
+		t.Errorf("Size = %d, want 1073741824", got.Size)
+	}
+	if got.Download != "magnet:?xt=urn:btih:abc123" {
+		t.Errorf("Download = %q", got.Download)
+	}
+	if got.EpisodeInfo.ImDB != "tt1234567" {
+		t.Errorf("EpisodeInfo.ImDB = %q", got.EpisodeInfo.ImDB)
+	}
+}
+
+func TestTorznabBuildQuery(t *testing.T) {
+	idx := NewTorznabIndexer("http://example.com/api", "testkey")
+	testData := []struct {
+		desc string
+		q    Query
+		want []string
+	}{
+		{
+			desc: "plain search",
+			q:    Query{SearchString: "movie"},
+			want: []string{"t=search", "q=movie", "apikey=testkey"},
+		},
+		{
+			desc: "imdb search",
+			q:    Query{IMDbID: "tt1234567"},
+			want: []string{"t=movie", "imdbid=1234567"},
+		},
+		{
+			desc: "tvdb search",
+			q:    Query{TVDBID: "123"},
+			want: []string{"t=tvsearch", "tvdbid=123"},
+		},
+	}
+	for i, tc := range testData {
+		got := idx.buildQuery(mode(tc.q), tc.q)
+		for _, want := range tc.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("Test(%d) %s: buildQuery() = %q, want substring %q", i, tc.desc, got, want)
+			}
+		}
+	}
+}